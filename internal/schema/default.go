@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// normalizeDefault converts a column default to the Go type stored for the
+// column's type. LoadSchema decodes numeric defaults as json.Number so int64
+// values keep full precision. Defaults set in Go may also be int, int64, or
+// float64; integral float64 values are accepted for int64 and timestamp
+// columns. For float32 columns, json.Number defaults are text and round to the
+// nearest float32 as Parse does, but Go float64 defaults must be exactly
+// representable as float32 so no precision is silently lost.
+func normalizeDefault(col Column) (any, error) {
+	switch v := col.Default.(type) {
+	case nil:
+		return nil, nil
+	case json.Number:
+		switch col.Type {
+		case TypeInt64, TypeTimestamp:
+			n, err := strconv.ParseInt(v.String(), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Default for column %s must be an integer in int64 range, got %s", col.Name, v)
+			}
+			return n, nil
+		case TypeFloat64:
+			f, err := strconv.ParseFloat(v.String(), 64)
+			if err != nil {
+				return nil, fmt.Errorf("Default for column %s is not a valid float64: %s", col.Name, v)
+			}
+			return f, nil
+		case TypeFloat32:
+			f, err := strconv.ParseFloat(v.String(), 32)
+			if err != nil {
+				return nil, fmt.Errorf("Default for column %s is not a valid float32: %s", col.Name, v)
+			}
+			return float32(f), nil
+		}
+	case int64:
+		if col.Type == TypeInt64 || col.Type == TypeTimestamp {
+			return v, nil
+		}
+	case int:
+		if col.Type == TypeInt64 || col.Type == TypeTimestamp {
+			return int64(v), nil
+		}
+	case float32:
+		if col.Type == TypeFloat32 {
+			return v, nil
+		}
+	case float64:
+		switch col.Type {
+		case TypeFloat64:
+			return v, nil
+		case TypeFloat32:
+			if f := float32(v); float64(f) == v || math.IsInf(v, 0) {
+				return f, nil
+			}
+			return nil, fmt.Errorf("Default for column %s is not exactly representable as float32: %v", col.Name, v)
+		case TypeInt64, TypeTimestamp:
+			// 2^63 is exactly representable; anything at or above it overflows.
+			if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+				return nil, fmt.Errorf("Default for column %s must be an integer in int64 range, got %v", col.Name, v)
+			}
+			return int64(v), nil
+		}
+	case bool:
+		if col.Type == TypeBool {
+			return v, nil
+		}
+	case string:
+		switch col.Type {
+		case TypeString:
+			if err := checkFixedWidth(col, v); err != nil {
+				return nil, fmt.Errorf("Default for column %s: %w", col.Name, err)
+			}
+			return v, nil
+		case TypeEnum:
+			for _, allowed := range col.Values {
+				if v == allowed {
+					return v, nil
+				}
+			}
+			return nil, fmt.Errorf("Default for column %s is not a declared enum value: %s", col.Name, v)
+		}
+	}
+
+	return nil, fmt.Errorf("Default for column %s has type %T, incompatible with %s", col.Name, col.Default, col.Type)
+}
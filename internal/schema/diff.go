@@ -0,0 +1,142 @@
+package schema
+
+import (
+	"reflect"
+	"slices"
+)
+
+// SchemaDiff describes the column-level changes between two schemas.
+// Every list is ordered by column position so the diff is deterministic:
+// Removed follows the old schema, all other lists follow the new schema.
+type SchemaDiff struct {
+	Added     []Column       // Columns only in the new schema
+	Removed   []Column       // Columns only in the old schema
+	Retyped   []ColumnRetype // Columns whose type changed
+	Changed   []ColumnChange // Columns whose other stored or read properties changed
+	Reordered []ColumnMove   // Columns whose order relative to the shared columns changed
+}
+
+// ColumnRetype records a type change for a column present in both schemas.
+type ColumnRetype struct {
+	Name    string
+	OldType ColumnType
+	NewType ColumnType
+}
+
+// ColumnChange records property changes, other than type, for a column
+// present in both schemas. Fields names each changed property by its JSON key,
+// in the order nullable, values, max_len, encoding, unique, default:
+//   - values: remaps stored enum indexes, even if only the order changed.
+//   - max_len: changes the fixed on-disk width.
+//   - encoding: changes the on-disk layout of new segments.
+//   - unique: changes which records the writer accepts.
+//   - default: changes what readers return for segments lacking the column.
+//
+// Description is documentation only and is not reported.
+type ColumnChange struct {
+	Name   string
+	Fields []string
+	Old    Column
+	New    Column
+}
+
+// ColumnMove records a column's position in the old and new schemas.
+type ColumnMove struct {
+	Name     string
+	OldIndex int
+	NewIndex int
+}
+
+// Diff compares two schemas by column name.
+//
+// A column counts as reordered only if its rank among the columns shared by
+// both schemas changes. Appending or removing columns does not reorder the
+// columns that remain.
+func Diff(old, next *Schema) SchemaDiff {
+	var d SchemaDiff
+
+	oldPos := make(map[string]int, len(old.Columns))
+	for i, col := range old.Columns {
+		oldPos[col.Name] = i
+	}
+
+	newPos := make(map[string]int, len(next.Columns))
+	for i, col := range next.Columns {
+		newPos[col.Name] = i
+	}
+
+	for _, col := range old.Columns {
+		if _, ok := newPos[col.Name]; !ok {
+			d.Removed = append(d.Removed, col)
+		}
+	}
+
+	// Rank of each shared column in the old schema, skipping removed columns.
+	oldRank := make(map[string]int)
+	for _, col := range old.Columns {
+		if _, ok := newPos[col.Name]; ok {
+			oldRank[col.Name] = len(oldRank)
+		}
+	}
+
+	rank := 0
+	for i, col := range next.Columns {
+		j, ok := oldPos[col.Name]
+		if !ok {
+			d.Added = append(d.Added, col)
+			continue
+		}
+
+		prev := old.Columns[j]
+		if prev.Type != col.Type {
+			d.Retyped = append(d.Retyped, ColumnRetype{Name: col.Name, OldType: prev.Type, NewType: col.Type})
+		}
+
+		if fields := changedFields(prev, col); len(fields) > 0 {
+			d.Changed = append(d.Changed, ColumnChange{Name: col.Name, Fields: fields, Old: prev, New: col})
+		}
+
+		if oldRank[col.Name] != rank {
+			d.Reordered = append(d.Reordered, ColumnMove{Name: col.Name, OldIndex: j, NewIndex: i})
+		}
+		rank++
+	}
+
+	return d
+}
+
+// changedFields lists the properties reported in ColumnChange that differ
+// between two versions of a column.
+func changedFields(prev, col Column) []string {
+	var fields []string
+	if prev.Nullable != col.Nullable {
+		fields = append(fields, "nullable")
+	}
+	if !slices.Equal(prev.Values, col.Values) {
+		fields = append(fields, "values")
+	}
+	if prev.MaxLen != col.MaxLen {
+		fields = append(fields, "max_len")
+	}
+	if prev.Encoding != col.Encoding {
+		fields = append(fields, "encoding")
+	}
+	if prev.Unique != col.Unique {
+		fields = append(fields, "unique")
+	}
+	if !defaultsEqual(prev, col) {
+		fields = append(fields, "default")
+	}
+	return fields
+}
+
+// defaultsEqual compares defaults after normalization, so a json.Number
+// default from a loaded schema equals the int64 it normalizes to.
+func defaultsEqual(prev, col Column) bool {
+	a, aErr := normalizeDefault(prev)
+	b, bErr := normalizeDefault(col)
+	if aErr != nil || bErr != nil {
+		return reflect.DeepEqual(prev.Default, col.Default)
+	}
+	return a == b
+}
@@ -0,0 +1,179 @@
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDiff_AddedNullableColumn(t *testing.T) {
+	old := &Schema{
+		Version: 1,
+		Columns: []Column{
+			{Name: "id", Type: TypeString},
+			{Name: "age", Type: TypeInt64},
+		},
+	}
+	next := &Schema{
+		Version: 2,
+		Columns: []Column{
+			{Name: "id", Type: TypeString},
+			{Name: "age", Type: TypeInt64},
+			{Name: "email", Type: TypeString, Nullable: true},
+		},
+	}
+
+	d := Diff(old, next)
+
+	if len(d.Added) != 1 || d.Added[0].Name != "email" || !d.Added[0].Nullable {
+		t.Fatalf("Expected 'email' to be added as nullable, got %+v", d.Added)
+	}
+
+	if len(d.Removed) != 0 || len(d.Retyped) != 0 || len(d.Changed) != 0 || len(d.Reordered) != 0 {
+		t.Fatalf("Expected only an addition, got %+v", d)
+	}
+}
+
+func TestDiff_Retype(t *testing.T) {
+	old := &Schema{
+		Version: 1,
+		Columns: []Column{
+			{Name: "id", Type: TypeString},
+			{Name: "age", Type: TypeInt64},
+		},
+	}
+	next := &Schema{
+		Version: 2,
+		Columns: []Column{
+			{Name: "id", Type: TypeString},
+			{Name: "age", Type: TypeFloat64},
+		},
+	}
+
+	d := Diff(old, next)
+
+	want := []ColumnRetype{{Name: "age", OldType: TypeInt64, NewType: TypeFloat64}}
+	if !reflect.DeepEqual(d.Retyped, want) {
+		t.Fatalf("Expected retype %+v, got %+v", want, d.Retyped)
+	}
+
+	if len(d.Added) != 0 || len(d.Removed) != 0 || len(d.Changed) != 0 || len(d.Reordered) != 0 {
+		t.Fatalf("Expected only a retype, got %+v", d)
+	}
+}
+
+func TestDiff_RemovedAndReordered(t *testing.T) {
+	old := &Schema{
+		Version: 1,
+		Columns: []Column{
+			{Name: "a", Type: TypeString},
+			{Name: "b", Type: TypeInt64},
+			{Name: "c", Type: TypeBool},
+			{Name: "d", Type: TypeFloat64},
+		},
+	}
+	next := &Schema{
+		Version: 2,
+		Columns: []Column{
+			{Name: "a", Type: TypeString},
+			{Name: "d", Type: TypeFloat64},
+			{Name: "c", Type: TypeBool},
+		},
+	}
+
+	d := Diff(old, next)
+
+	if len(d.Removed) != 1 || d.Removed[0].Name != "b" {
+		t.Fatalf("Expected 'b' to be removed, got %+v", d.Removed)
+	}
+
+	// Removing 'b' alone would not reorder anything; swapping 'c' and 'd' does.
+	want := []ColumnMove{
+		{Name: "d", OldIndex: 3, NewIndex: 1},
+		{Name: "c", OldIndex: 2, NewIndex: 2},
+	}
+	if !reflect.DeepEqual(d.Reordered, want) {
+		t.Fatalf("Expected reordered %+v, got %+v", want, d.Reordered)
+	}
+}
+
+func TestDiff_ChangedProperties(t *testing.T) {
+	old := &Schema{
+		Version: 1,
+		Columns: []Column{
+			{Name: "status", Type: TypeEnum, Values: []string{"a", "b"}},
+			{Name: "country", Type: TypeString, MaxLen: 2},
+			{Name: "age", Type: TypeInt64},
+		},
+	}
+	next := &Schema{
+		Version: 2,
+		Columns: []Column{
+			{Name: "status", Type: TypeEnum, Values: []string{"b", "a"}},
+			{Name: "country", Type: TypeString, MaxLen: 3, Nullable: true},
+			{Name: "age", Type: TypeInt64},
+		},
+	}
+
+	d := Diff(old, next)
+
+	if len(d.Changed) != 2 {
+		t.Fatalf("Expected 2 changed columns, got %+v", d.Changed)
+	}
+
+	// Reordering enum values remaps the stored indexes, so it must be reported.
+	if d.Changed[0].Name != "status" || !reflect.DeepEqual(d.Changed[0].Fields, []string{"values"}) {
+		t.Fatalf("Expected 'status' values change, got %+v", d.Changed[0])
+	}
+
+	if d.Changed[1].Name != "country" || !reflect.DeepEqual(d.Changed[1].Fields, []string{"nullable", "max_len"}) {
+		t.Fatalf("Expected 'country' nullable and max_len change, got %+v", d.Changed[1])
+	}
+
+	if len(d.Added) != 0 || len(d.Removed) != 0 || len(d.Retyped) != 0 || len(d.Reordered) != 0 {
+		t.Fatalf("Expected only property changes, got %+v", d)
+	}
+}
+
+func TestDiff_EncodingUniqueAndDefault(t *testing.T) {
+	old := &Schema{
+		Version: 1,
+		Columns: []Column{
+			{Name: "s", Type: TypeString},
+			{Name: "id", Type: TypeString},
+			{Name: "age", Type: TypeInt64, Default: json.Number("0")},
+			{Name: "score", Type: TypeInt64, Default: int64(0)},
+		},
+	}
+	next := &Schema{
+		Version: 2,
+		Columns: []Column{
+			{Name: "s", Type: TypeString, Encoding: EncodingDictionary},
+			{Name: "id", Type: TypeString, Unique: true},
+			{Name: "age", Type: TypeInt64, Default: int64(1)},
+			// Same default in a different Go representation is not a change.
+			{Name: "score", Type: TypeInt64, Default: json.Number("0")},
+		},
+	}
+
+	d := Diff(old, next)
+
+	want := []struct {
+		name   string
+		fields []string
+	}{
+		{"s", []string{"encoding"}},
+		{"id", []string{"unique"}},
+		{"age", []string{"default"}},
+	}
+
+	if len(d.Changed) != len(want) {
+		t.Fatalf("Expected %d changed columns, got %+v", len(want), d.Changed)
+	}
+
+	for i, w := range want {
+		if d.Changed[i].Name != w.name || !reflect.DeepEqual(d.Changed[i].Fields, w.fields) {
+			t.Fatalf("Expected %s to change %v, got %+v", w.name, w.fields, d.Changed[i])
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package schema
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 )
 
@@ -14,11 +16,20 @@ func LoadSchema(path string) (*Schema, error) {
 		return nil, fmt.Errorf("Failed to read schema file: %w", err)
 	}
 
+	// UseNumber keeps numeric defaults exact; float64 would round int64
+	// defaults above 2^53.
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
 	var s Schema
-	if err := json.Unmarshal(data, &s); err != nil {
+	if err := dec.Decode(&s); err != nil {
 		return nil, fmt.Errorf("Failed to parse schema json: %w", err)
 	}
 
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("Failed to parse schema json: unexpected data after schema")
+	}
+
 	if err := ValidateSchema(&s); err != nil {
 		return nil, fmt.Errorf("Invalid schema: %w", err)
 	}
@@ -0,0 +1,101 @@
+package schema
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Parse converts a textual value (e.g. a CSV field) into the Go value stored
+// for the column's type: int64, float64, float32, bool, or string (for string
+// and enum columns). Float32 values round to the nearest float32; only values
+// that overflow float32 are rejected. Timestamps accept RFC3339 or epoch
+// milliseconds and are returned as epoch milliseconds.
+//
+// For columns with a MaxLen, values longer than MaxLen bytes or ending in
+// a space or NUL padding byte are rejected (see checkFixedWidth).
+// An empty string is null for nullable columns and Parse returns nil.
+// For non-nullable string columns it is the empty string.
+func Parse(col Column, raw string) (any, error) {
+	if raw == "" && col.Nullable {
+		return nil, nil
+	}
+
+	switch col.Type {
+	case TypeInt64:
+		v, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid int64 value for column %s: %w", col.Name, err)
+		}
+		return v, nil
+
+	case TypeFloat64:
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid float64 value for column %s: %w", col.Name, err)
+		}
+		return v, nil
+
+	case TypeFloat32:
+		v, err := strconv.ParseFloat(raw, 32)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid float32 value for column %s: %w", col.Name, err)
+		}
+		return float32(v), nil
+
+	case TypeBool:
+		switch raw {
+		case "true", "1":
+			return true, nil
+		case "false", "0":
+			return false, nil
+		}
+		return nil, fmt.Errorf("Invalid bool value for column %s: %q", col.Name, raw)
+
+	case TypeString:
+		if err := checkFixedWidth(col, raw); err != nil {
+			return nil, fmt.Errorf("Invalid string value for column %s: %w", col.Name, err)
+		}
+		return raw, nil
+
+	case TypeTimestamp:
+		if ms, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			return ms, nil
+		}
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid timestamp value for column %s: %q is neither RFC3339 nor epoch millis", col.Name, raw)
+		}
+		return t.UnixMilli(), nil
+
+	case TypeEnum:
+		for _, v := range col.Values {
+			if raw == v {
+				return raw, nil
+			}
+		}
+		return nil, fmt.Errorf("Invalid enum value for column %s: %q", col.Name, raw)
+	}
+
+	return nil, fmt.Errorf("Unsupported column type: %s", col.Type)
+}
+
+// checkFixedWidth rejects string values that cannot round-trip through
+// fixed-width storage. Values are padded to MaxLen bytes with spaces or NULs
+// and readers trim that padding, so a value ending in either byte would come
+// back shorter than it was written.
+func checkFixedWidth(col Column, v string) error {
+	if col.MaxLen == 0 {
+		return nil
+	}
+
+	if len(v) > col.MaxLen {
+		return fmt.Errorf("%d bytes exceeds MaxLen %d", len(v), col.MaxLen)
+	}
+
+	if n := len(v); n > 0 && (v[n-1] == ' ' || v[n-1] == 0) {
+		return fmt.Errorf("trailing padding byte %q is not preserved in fixed-width storage", v[n-1])
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse_Valid(t *testing.T) {
+	tests := []struct {
+		col  Column
+		raw  string
+		want any
+	}{
+		{Column{Name: "age", Type: TypeInt64}, "-42", int64(-42)},
+		{Column{Name: "income", Type: TypeFloat64}, "1234.5", 1234.5},
+		{Column{Name: "reading", Type: TypeFloat32}, "-0.25", float32(-0.25)},
+		{Column{Name: "reading", Type: TypeFloat32}, "0.1", float32(0.1)},
+		{Column{Name: "active", Type: TypeBool}, "true", true},
+		{Column{Name: "active", Type: TypeBool}, "1", true},
+		{Column{Name: "active", Type: TypeBool}, "false", false},
+		{Column{Name: "active", Type: TypeBool}, "0", false},
+		{Column{Name: "id", Type: TypeString}, "abc", "abc"},
+		{Column{Name: "id", Type: TypeString}, "", ""},
+		{Column{Name: "country", Type: TypeString, MaxLen: 2}, "US", "US"},
+		{Column{Name: "created_at", Type: TypeTimestamp}, "1700000000123", int64(1700000000123)},
+		{Column{Name: "created_at", Type: TypeTimestamp}, "2023-11-14T22:13:20.123Z", int64(1700000000123)},
+		{Column{Name: "created_at", Type: TypeTimestamp}, "2023-11-14T17:13:20.123-05:00", int64(1700000000123)},
+		{Column{Name: "status", Type: TypeEnum, Values: []string{"active", "trial"}}, "trial", "trial"},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.col, tt.raw)
+		if err != nil {
+			t.Fatalf("Parse(%s, %q): unexpected error: %v", tt.col.Type, tt.raw, err)
+		}
+
+		if got != tt.want {
+			t.Fatalf("Parse(%s, %q): expected %T %v, got %T %v", tt.col.Type, tt.raw, tt.want, tt.want, got, got)
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		col Column
+		raw string
+	}{
+		{Column{Name: "age", Type: TypeInt64}, "1.5"},
+		{Column{Name: "age", Type: TypeInt64}, "99999999999999999999"},
+		{Column{Name: "income", Type: TypeFloat64}, "abc"},
+		{Column{Name: "reading", Type: TypeFloat32}, "1e39"},
+		{Column{Name: "active", Type: TypeBool}, "yes"},
+		{Column{Name: "country", Type: TypeString, MaxLen: 2}, "USA"},
+		{Column{Name: "country", Type: TypeString, MaxLen: 3}, "U "},
+		{Column{Name: "country", Type: TypeString, MaxLen: 3}, "U\x00"},
+		{Column{Name: "created_at", Type: TypeTimestamp}, "2023-11-14"},
+		{Column{Name: "status", Type: TypeEnum, Values: []string{"active"}}, "churned"},
+		{Column{Name: "status", Type: TypeEnum, Values: []string{"active"}, Nullable: false}, ""},
+		{Column{Name: "age", Type: TypeInt64, Nullable: false}, ""},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(tt.col, tt.raw)
+		if err == nil {
+			t.Fatalf("Parse(%s, %q): expected error", tt.col.Type, tt.raw)
+		}
+
+		if !strings.Contains(err.Error(), tt.col.Name) {
+			t.Fatalf("Parse(%s, %q): expected error naming column %s, got: %v", tt.col.Type, tt.raw, tt.col.Name, err)
+		}
+	}
+}
+
+func TestParse_NullableEmpty(t *testing.T) {
+	for _, typ := range []ColumnType{TypeInt64, TypeFloat64, TypeFloat32, TypeBool, TypeString, TypeTimestamp, TypeEnum} {
+		col := Column{Name: "c", Type: typ, Nullable: true}
+		if typ == TypeEnum {
+			col.Values = []string{"active", "trial"}
+		}
+
+		got, err := Parse(col, "")
+		if err != nil {
+			t.Fatalf("Parse(%s, \"\"): unexpected error: %v", typ, err)
+		}
+
+		if got != nil {
+			t.Fatalf("Parse(%s, \"\"): expected nil, got %v", typ, got)
+		}
+	}
+}
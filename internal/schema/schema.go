@@ -16,20 +16,60 @@ const (
 	TypeInt64 ColumnType = "int64"
 	// TypeFloat64 represents 64-bit floating point numbers.
 	TypeFloat64 ColumnType = "float64"
+	// TypeFloat32 represents 32-bit floating point numbers.
+	TypeFloat32 ColumnType = "float32"
 	// TypeBool represents boolean values.
 	TypeBool ColumnType = "bool"
 	// TypeString represents UTF-8 strings.
 	TypeString ColumnType = "string"
 	// TypeTimestamp represents Unix epoch milliseconds (UTC).
 	TypeTimestamp ColumnType = "timestamp"
+	// TypeEnum represents a string drawn from a fixed, schema-declared set.
+	TypeEnum ColumnType = "enum"
+)
+
+// MaxEnumValues is the largest value set an enum column may declare.
+// Enum values are stored as a single byte index per record.
+const MaxEnumValues = 256
+
+// MaxFixedWidth is the largest MaxLen a string column may declare.
+// Fixed-width columns pad every record to MaxLen bytes, so the mode is meant
+// for short codes; longer values belong in variable-width storage.
+const MaxFixedWidth = 255
+
+// Encoding names the on-disk encoding requested for a column.
+// An empty Encoding leaves the choice to the writer's default for the type.
+type Encoding string
+
+const (
+	// EncodingRaw stores fixed-width values as-is.
+	EncodingRaw Encoding = "raw"
+	// EncodingDictionary stores ids into a per-segment dictionary.
+	EncodingDictionary Encoding = "dictionary"
+	// EncodingRLE stores runs of repeated values.
+	EncodingRLE Encoding = "rle"
+	// EncodingBitPacked stores values using the minimum number of bits.
+	EncodingBitPacked Encoding = "bitpacked"
+	// EncodingDelta stores differences between consecutive values.
+	EncodingDelta Encoding = "delta"
 )
 
 // Column defines a single field in the schema.
+//
+// Unique declares a per-segment constraint that the segment writer is
+// expected to enforce. The same value may appear in different segments;
+// there is no cross-segment index to check against.
 type Column struct {
-	Name     string     `json:"name"`     // Column name (unique within schema)
-	Type     ColumnType `json:"type"`     // Data type
-	Nullable bool       `json:"nullable"` // Whether null values are allowed
-	Index    int        `json:"-"`        // Runtime position index (set by InitializeSchema)
+	Name        string     `json:"name"`                  // Column name (unique within schema)
+	Type        ColumnType `json:"type"`                  // Data type
+	Nullable    bool       `json:"nullable"`              // Whether null values are allowed
+	Encoding    Encoding   `json:"encoding,omitempty"`    // Optional encoding hint
+	Values      []string   `json:"values,omitempty"`      // Allowed values (enum columns only)
+	MaxLen      int        `json:"max_len,omitempty"`     // Fixed byte width (string columns only, 0 = variable)
+	Default     any        `json:"default,omitempty"`     // Value used where a record has none (nil = no default)
+	Unique      bool       `json:"unique,omitempty"`      // Values must not repeat within a segment
+	Description string     `json:"description,omitempty"` // Free-form documentation, not interpreted by storage
+	Index       int        `json:"-"`                     // Runtime position index (set by InitializeSchema)
 }
 
 // Schema defines the structure of stored data.
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 )
@@ -132,13 +134,18 @@ func TestValidateSchema_InvalidType(t *testing.T) {
 	s := &Schema{
 		Version: 1,
 		Columns: []Column{
-			{Name: "foo", Type: ColumnType("enum"), Nullable: false},
+			{Name: "foo", Type: ColumnType("decimal"), Nullable: false},
 		},
 	}
 
-	if err := ValidateSchema(s); err == nil {
+	err := ValidateSchema(s)
+	if err == nil {
 		t.Fatalf("expected error for invalid column type")
 	}
+
+	if !strings.Contains(err.Error(), "Unsupported column type") {
+		t.Fatalf("Expected unsupported type error, got: %v", err)
+	}
 }
 
 func TestValidateSchema_AllColumnTypes(t *testing.T) {
@@ -148,6 +155,7 @@ func TestValidateSchema_AllColumnTypes(t *testing.T) {
 			{Name: "string_col", Type: TypeString, Nullable: true},
 			{Name: "int64_col", Type: TypeInt64, Nullable: false},
 			{Name: "float64_col", Type: TypeFloat64, Nullable: false},
+			{Name: "float32_col", Type: TypeFloat32, Nullable: false},
 			{Name: "bool_col", Type: TypeBool, Nullable: true},
 			{Name: "timestamp_col", Type: TypeTimestamp, Nullable: false},
 		},
@@ -178,3 +186,328 @@ func TestInitializeSchema_SetsIndexes(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateSchema_Encoding(t *testing.T) {
+	s := &Schema{
+		Version: 1,
+		Columns: []Column{
+			{Name: "id", Type: TypeString, Nullable: false, Encoding: EncodingDictionary},
+			{Name: "age", Type: TypeInt64, Nullable: false},
+		},
+	}
+
+	if err := ValidateSchema(s); err != nil {
+		t.Fatalf("Expected known encoding to be valid, got error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		col  Column
+	}{
+		{"unknown", Column{Name: "age", Type: TypeInt64, Encoding: "zigzag"}},
+		{"delta on bool", Column{Name: "active", Type: TypeBool, Encoding: EncodingDelta}},
+		{"dictionary on bool", Column{Name: "active", Type: TypeBool, Encoding: EncodingDictionary}},
+		{"dictionary on float64", Column{Name: "income", Type: TypeFloat64, Encoding: EncodingDictionary}},
+		{"dictionary on enum", Column{Name: "status", Type: TypeEnum, Values: []string{"a"}, Encoding: EncodingDictionary}},
+	}
+
+	for _, tt := range tests {
+		s := &Schema{Version: 1, Columns: []Column{tt.col}}
+		err := ValidateSchema(s)
+		if err == nil {
+			t.Fatalf("%s: expected error", tt.name)
+		}
+
+		if !strings.Contains(err.Error(), "Unsupported encoding") {
+			t.Fatalf("%s: expected unsupported encoding error, got: %v", tt.name, err)
+		}
+	}
+}
+
+func TestValidateSchema_Enum(t *testing.T) {
+	s := &Schema{
+		Version: 1,
+		Columns: []Column{
+			{Name: "status", Type: TypeEnum, Nullable: true, Values: []string{"active", "churned", "trial"}},
+		},
+	}
+
+	if err := ValidateSchema(s); err != nil {
+		t.Fatalf("Expected enum column to be valid, got error: %v", err)
+	}
+}
+
+func TestValidateSchema_EnumInvalidValues(t *testing.T) {
+	tooMany := make([]string, MaxEnumValues+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("v%d", i)
+	}
+
+	tests := []struct {
+		name   string
+		col    Column
+		errMsg string
+	}{
+		{"no values", Column{Name: "status", Type: TypeEnum}, "at least one value"},
+		{"too many values", Column{Name: "status", Type: TypeEnum, Values: tooMany}, "max is 256"},
+		{"duplicate value", Column{Name: "status", Type: TypeEnum, Values: []string{"a", "a"}}, "Duplicate enum value"},
+		{"empty value", Column{Name: "status", Type: TypeEnum, Nullable: true, Values: []string{"", "a"}}, "Enum value cannot be empty"},
+		{"values on non-enum", Column{Name: "status", Type: TypeString, Values: []string{"a"}}, "only allowed on enum columns"},
+	}
+
+	for _, tt := range tests {
+		s := &Schema{Version: 1, Columns: []Column{tt.col}}
+		err := ValidateSchema(s)
+		if err == nil {
+			t.Fatalf("%s: expected error", tt.name)
+		}
+
+		if !strings.Contains(err.Error(), tt.errMsg) {
+			t.Fatalf("%s: expected error containing %q, got: %v", tt.name, tt.errMsg, err)
+		}
+	}
+}
+
+func TestLoadSchema_Defaults(t *testing.T) {
+	s, err := LoadSchema("../../testdata/valid_schema_defaults.json")
+	if err != nil {
+		t.Fatalf("Expected valid schema, got error: %v", err)
+	}
+
+	// Defaults decoded from JSON must be normalized to the column's Go type.
+	if s.Columns[0].Default != nil {
+		t.Fatalf("Expected no default for 'id', got %v", s.Columns[0].Default)
+	}
+
+	if v, ok := s.Columns[1].Default.(int64); !ok || v != 0 {
+		t.Fatalf("Expected int64 default 0 for 'age', got %T %v", s.Columns[1].Default, s.Columns[1].Default)
+	}
+
+	if v, ok := s.Columns[2].Default.(float64); !ok || v != 1.5 {
+		t.Fatalf("Expected float64 default 1.5 for 'income', got %T %v", s.Columns[2].Default, s.Columns[2].Default)
+	}
+
+	if v, ok := s.Columns[3].Default.(bool); !ok || !v {
+		t.Fatalf("Expected bool default true for 'active', got %T %v", s.Columns[3].Default, s.Columns[3].Default)
+	}
+
+	if v, ok := s.Columns[4].Default.(int64); !ok || v != 1700000000000 {
+		t.Fatalf("Expected int64 default 1700000000000 for 'created_at', got %T %v", s.Columns[4].Default, s.Columns[4].Default)
+	}
+}
+
+func TestLoadSchema_DefaultAbove2Pow53(t *testing.T) {
+	s, err := LoadSchema("../../testdata/valid_schema_large_default.json")
+	if err != nil {
+		t.Fatalf("Expected valid schema, got error: %v", err)
+	}
+
+	// 2^53 + 1 is not representable as float64 and must survive decoding.
+	if v, ok := s.Columns[0].Default.(int64); !ok || v != 9007199254740993 {
+		t.Fatalf("Expected int64 default 9007199254740993, got %T %v", s.Columns[0].Default, s.Columns[0].Default)
+	}
+}
+
+func TestInitializeSchema_Float32JSONDefaultRounds(t *testing.T) {
+	s := &Schema{
+		Version: 1,
+		Columns: []Column{
+			{Name: "reading", Type: TypeFloat32, Default: json.Number("0.1")},
+		},
+	}
+
+	if err := ValidateSchema(s); err != nil {
+		t.Fatalf("Expected json.Number float32 default to be valid, got error: %v", err)
+	}
+
+	InitializeSchema(s)
+
+	// Textual defaults must round the same way Parse does.
+	want, _ := Parse(s.Columns[0], "0.1")
+	if s.Columns[0].Default != want {
+		t.Fatalf("Expected default %v, got %T %v", want, s.Columns[0].Default, s.Columns[0].Default)
+	}
+}
+
+func TestValidateSchema_Float32DefaultMustBeExact(t *testing.T) {
+	s := &Schema{
+		Version: 1,
+		Columns: []Column{
+			{Name: "reading", Type: TypeFloat32, Default: 0.5},
+		},
+	}
+
+	if err := ValidateSchema(s); err != nil {
+		t.Fatalf("Expected exact float32 default to be valid, got error: %v", err)
+	}
+
+	// 2^24 + 1 and 0.1 both lose precision as float32.
+	for _, v := range []float64{16777217.0, 0.1} {
+		s.Columns[0].Default = v
+		err := ValidateSchema(s)
+		if err == nil {
+			t.Fatalf("Expected error for inexact float32 default %v", v)
+		}
+
+		if !strings.Contains(err.Error(), "not exactly representable as float32") {
+			t.Fatalf("Expected float32 precision error for %v, got: %v", v, err)
+		}
+	}
+}
+
+func TestValidateSchema_InvalidDefault(t *testing.T) {
+	tests := []struct {
+		name string
+		col  Column
+	}{
+		{"string for int64", Column{Name: "age", Type: TypeInt64, Default: "zero"}},
+		{"fractional json number for int64", Column{Name: "age", Type: TypeInt64, Default: json.Number("1.5")}},
+		{"overflowing json number for int64", Column{Name: "age", Type: TypeInt64, Default: json.Number("9223372036854775808")}},
+		{"fraction for int64", Column{Name: "age", Type: TypeInt64, Default: 1.5}},
+		{"overflow for timestamp", Column{Name: "ts", Type: TypeTimestamp, Default: 1e19}},
+		{"number for bool", Column{Name: "active", Type: TypeBool, Default: 1.0}},
+		{"overflow for float32", Column{Name: "reading", Type: TypeFloat32, Default: 1e39}},
+		{"overflowing json number for float32", Column{Name: "reading", Type: TypeFloat32, Default: json.Number("1e39")}},
+		{"bool for string", Column{Name: "id", Type: TypeString, Default: false}},
+		{"undeclared enum value", Column{Name: "status", Type: TypeEnum, Values: []string{"a"}, Default: "b"}},
+	}
+
+	for _, tt := range tests {
+		s := &Schema{Version: 1, Columns: []Column{tt.col}}
+		err := ValidateSchema(s)
+		if err == nil {
+			t.Fatalf("%s: expected error", tt.name)
+		}
+
+		if !strings.Contains(err.Error(), "Default for column") {
+			t.Fatalf("%s: expected default error, got: %v", tt.name, err)
+		}
+	}
+}
+
+func TestValidateSchema_Unique(t *testing.T) {
+	s := &Schema{
+		Version: 1,
+		Columns: []Column{
+			{Name: "id", Type: TypeString, Nullable: false, Unique: true},
+		},
+	}
+
+	if err := ValidateSchema(s); err != nil {
+		t.Fatalf("Expected unique non-nullable column to be valid, got error: %v", err)
+	}
+
+	s.Columns[0].Nullable = true
+	err := ValidateSchema(s)
+	if err == nil {
+		t.Fatalf("Expected error for unique nullable column")
+	}
+
+	if !strings.Contains(err.Error(), "Unique column cannot be nullable") {
+		t.Fatalf("Expected unique nullable error, got: %v", err)
+	}
+
+	s.Columns[0].Nullable = false
+	s.Columns[0].Default = "x"
+	err = ValidateSchema(s)
+	if err == nil {
+		t.Fatalf("Expected error for unique column with a default")
+	}
+
+	if !strings.Contains(err.Error(), "Unique column cannot have a default") {
+		t.Fatalf("Expected unique default error, got: %v", err)
+	}
+}
+
+func TestLoadSchema_Descriptions(t *testing.T) {
+	s, err := LoadSchema("../../testdata/valid_schema_descriptions.json")
+	if err != nil {
+		t.Fatalf("Expected valid schema, got error: %v", err)
+	}
+
+	if s.Columns[0].Description != "Stable customer identifier" {
+		t.Fatalf("Expected description for 'id', got %q", s.Columns[0].Description)
+	}
+
+	if s.Columns[1].Description != "" {
+		t.Fatalf("Expected no description for 'age', got %q", s.Columns[1].Description)
+	}
+}
+
+func TestValidateSchema_MaxLen(t *testing.T) {
+	s := &Schema{
+		Version: 1,
+		Columns: []Column{
+			{Name: "country", Type: TypeString, Nullable: false, MaxLen: 2, Default: "US"},
+		},
+	}
+
+	if err := ValidateSchema(s); err != nil {
+		t.Fatalf("Expected fixed-width string column to be valid, got error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		col    Column
+		errMsg string
+	}{
+		{"negative", Column{Name: "country", Type: TypeString, MaxLen: -1}, "cannot be negative"},
+		{"too wide", Column{Name: "country", Type: TypeString, MaxLen: MaxFixedWidth + 1}, "max is 255"},
+		{"non-string", Column{Name: "age", Type: TypeInt64, MaxLen: 2}, "only allowed on string columns"},
+		{"default too long", Column{Name: "country", Type: TypeString, MaxLen: 2, Default: "USA"}, "exceeds MaxLen"},
+		{"default with trailing space", Column{Name: "country", Type: TypeString, MaxLen: 3, Default: "U "}, "trailing padding byte"},
+		{"dictionary encoding", Column{Name: "country", Type: TypeString, MaxLen: 2, Encoding: EncodingDictionary}, "cannot be combined with dictionary"},
+	}
+
+	for _, tt := range tests {
+		s := &Schema{Version: 1, Columns: []Column{tt.col}}
+		err := ValidateSchema(s)
+		if err == nil {
+			t.Fatalf("%s: expected error", tt.name)
+		}
+
+		if !strings.Contains(err.Error(), tt.errMsg) {
+			t.Fatalf("%s: expected error containing %q, got: %v", tt.name, tt.errMsg, err)
+		}
+	}
+}
+
+func TestValidateSchema_CaseInsensitiveDuplicate(t *testing.T) {
+	s := &Schema{
+		Version: 1,
+		Columns: []Column{
+			{Name: "id", Type: TypeString, Nullable: false},
+			{Name: "ID", Type: TypeInt64, Nullable: false},
+		},
+	}
+
+	err := ValidateSchema(s)
+	if err == nil {
+		t.Fatalf("Expected error for column names differing only by case")
+	}
+
+	if !strings.Contains(err.Error(), "ID conflicts with id") {
+		t.Fatalf("Expected case-insensitive duplicate error, got: %v", err)
+	}
+}
+
+func TestValidateSchema_CaseFoldedDuplicate(t *testing.T) {
+	// Lowercasing leaves these distinct, but case folding (as used by APFS
+	// and NTFS) maps final sigma to sigma.
+	s := &Schema{
+		Version: 1,
+		Columns: []Column{
+			{Name: "σ", Type: TypeString, Nullable: false},
+			{Name: "ς", Type: TypeInt64, Nullable: false},
+		},
+	}
+
+	err := ValidateSchema(s)
+	if err == nil {
+		t.Fatalf("Expected error for column names equal under case folding")
+	}
+
+	if !strings.Contains(err.Error(), "Duplicate column name (case-insensitive)") {
+		t.Fatalf("Expected case-insensitive duplicate error, got: %v", err)
+	}
+}
@@ -1,6 +1,28 @@
 package schema
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// allowedEncodings lists the encoding hints that make sense for each type:
+//   - int64 and timestamp values are integers, so bit-packing and delta apply.
+//   - Float bit patterns do not shrink under bit-packing or delta.
+//   - Bool is naturally bit-packed; there is no byte-per-value raw form.
+//   - Dictionary encoding only pays off for strings.
+//   - Enum values are already one-byte indexes, so delta and bit-packing are
+//     not offered yet.
+var allowedEncodings = map[ColumnType][]Encoding{
+	TypeInt64:     {EncodingRaw, EncodingRLE, EncodingBitPacked, EncodingDelta},
+	TypeTimestamp: {EncodingRaw, EncodingRLE, EncodingBitPacked, EncodingDelta},
+	TypeFloat64:   {EncodingRaw, EncodingRLE},
+	TypeFloat32:   {EncodingRaw, EncodingRLE},
+	TypeBool:      {EncodingBitPacked, EncodingRLE},
+	TypeString:    {EncodingRaw, EncodingDictionary, EncodingRLE},
+	TypeEnum:      {EncodingRaw, EncodingRLE},
+}
 
 // ValidateSchema ensures schema meets all structural requirements.
 // Returns error for any violation, nil for valid schemas.
@@ -14,6 +36,9 @@ func ValidateSchema(s *Schema) error {
 	}
 
 	seen := make(map[string]struct{})
+	// Column names become file names, which collide on case-insensitive
+	// filesystems (macOS, Windows) if they differ only by case.
+	folded := make(map[string]string)
 
 	for _, col := range s.Columns {
 		if col.Name == "" {
@@ -25,13 +50,104 @@ func ValidateSchema(s *Schema) error {
 		}
 		seen[col.Name] = struct{}{}
 
+		key := foldName(col.Name)
+		if other, ok := folded[key]; ok {
+			return fmt.Errorf("Duplicate column name (case-insensitive): %s conflicts with %s", col.Name, other)
+		}
+		folded[key] = col.Name
+
 		switch col.Type {
-		case TypeInt64, TypeFloat64, TypeBool, TypeString, TypeTimestamp:
-			// Valid type
+		case TypeInt64, TypeFloat64, TypeFloat32, TypeBool, TypeString, TypeTimestamp:
+			if len(col.Values) > 0 {
+				return fmt.Errorf("Values are only allowed on enum columns: %s", col.Name)
+			}
+		case TypeEnum:
+			if err := validateEnumValues(col); err != nil {
+				return err
+			}
 		default:
 			return fmt.Errorf("Unsupported column type: %s", col.Type)
 		}
 
+		if col.MaxLen < 0 {
+			return fmt.Errorf("MaxLen cannot be negative: %s", col.Name)
+		}
+
+		if col.MaxLen > MaxFixedWidth {
+			return fmt.Errorf("MaxLen for column %s is %d, max is %d", col.Name, col.MaxLen, MaxFixedWidth)
+		}
+
+		if col.MaxLen > 0 && col.Type != TypeString {
+			return fmt.Errorf("MaxLen is only allowed on string columns: %s", col.Name)
+		}
+
+		// Fixed-width mode stores values inline, without length prefixes or a dictionary.
+		if col.MaxLen > 0 && col.Encoding == EncodingDictionary {
+			return fmt.Errorf("MaxLen cannot be combined with dictionary encoding: %s", col.Name)
+		}
+
+		if col.Unique && col.Nullable {
+			return fmt.Errorf("Unique column cannot be nullable: %s", col.Name)
+		}
+
+		// A default would be filled into every record missing the key,
+		// so the second such record would always be a duplicate.
+		if col.Unique && col.Default != nil {
+			return fmt.Errorf("Unique column cannot have a default: %s", col.Name)
+		}
+
+		if _, err := normalizeDefault(col); err != nil {
+			return err
+		}
+
+		if col.Encoding != "" && !slices.Contains(allowedEncodings[col.Type], col.Encoding) {
+			return fmt.Errorf("Unsupported encoding for %s column %s: %s", col.Type, col.Name, col.Encoding)
+		}
+	}
+
+	return nil
+}
+
+// foldName maps a column name to a key shared by every name it equals under
+// Unicode case folding, matching strings.EqualFold. Each rune is replaced by
+// the smallest rune in its case-folding orbit, so e.g. "σ", "ς" and "Σ" share
+// a key, which plain lowercasing misses.
+func foldName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		lowest := r
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			if f < lowest {
+				lowest = f
+			}
+		}
+		b.WriteRune(lowest)
+	}
+	return b.String()
+}
+
+// validateEnumValues checks that an enum column declares a usable value set.
+// Indexes must fit in one byte, and each value must map to exactly one index.
+func validateEnumValues(col Column) error {
+	if len(col.Values) == 0 {
+		return fmt.Errorf("Enum column must declare at least one value: %s", col.Name)
+	}
+
+	if len(col.Values) > MaxEnumValues {
+		return fmt.Errorf("Enum column %s declares %d values, max is %d", col.Name, len(col.Values), MaxEnumValues)
+	}
+
+	seen := make(map[string]struct{}, len(col.Values))
+	for _, v := range col.Values {
+		// Parse treats "" as null on nullable columns, so it cannot be a value.
+		if v == "" {
+			return fmt.Errorf("Enum value cannot be empty in column %s", col.Name)
+		}
+
+		if _, ok := seen[v]; ok {
+			return fmt.Errorf("Duplicate enum value in column %s: %s", col.Name, v)
+		}
+		seen[v] = struct{}{}
 	}
 
 	return nil
@@ -42,5 +158,7 @@ func ValidateSchema(s *Schema) error {
 func InitializeSchema(s *Schema) {
 	for i := range s.Columns {
 		s.Columns[i].Index = i
+		// Validation already accepted the default, so the error is always nil.
+		s.Columns[i].Default, _ = normalizeDefault(s.Columns[i])
 	}
 }